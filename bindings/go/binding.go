@@ -0,0 +1,16 @@
+package tree_sitter_rigz
+
+// #cgo CFLAGS: -std=c11 -fPIC
+// #include "../../src/parser.c"
+// #include "../../src/scanner.c"
+//
+// TSLanguage *tree_sitter_rigz(void);
+import "C"
+
+import "unsafe"
+
+// Language returns the tree-sitter.Language for this grammar, for use
+// with the github.com/tree-sitter/go-tree-sitter bindings.
+func Language() unsafe.Pointer {
+	return unsafe.Pointer(C.tree_sitter_rigz())
+}
@@ -0,0 +1,197 @@
+package rigz
+
+import sitter "github.com/tree-sitter/go-tree-sitter"
+
+// File is the root of a parsed Rigz source file.
+type File struct {
+	node
+}
+
+func newFile(n sitter.Node, src []byte) *File {
+	return &File{node{n: n, src: src}}
+}
+
+// Imports returns the top-level import statements, in source order.
+func (f *File) Imports() []*ImportDecl {
+	var out []*ImportDecl
+	walkNamedChildren(f.n, func(c sitter.Node) {
+		if c.Type() == kindImportStatement {
+			out = append(out, &ImportDecl{node{n: c, src: f.src}})
+		}
+	})
+	return out
+}
+
+// Functions returns the top-level function declarations, in source order.
+func (f *File) Functions() []*FunctionDecl {
+	var out []*FunctionDecl
+	walkNamedChildren(f.n, func(c sitter.Node) {
+		if c.Type() == kindFunctionDef {
+			out = append(out, &FunctionDecl{node{n: c, src: f.src}})
+		}
+	})
+	return out
+}
+
+// Types returns the top-level type declarations, in source order.
+func (f *File) Types() []*TypeDecl {
+	var out []*TypeDecl
+	walkNamedChildren(f.n, func(c sitter.Node) {
+		if c.Type() == kindTypeDef {
+			out = append(out, &TypeDecl{node{n: c, src: f.src}})
+		}
+	})
+	return out
+}
+
+func walkNamedChildren(n sitter.Node, fn func(sitter.Node)) {
+	for i := uint(0); i < n.NamedChildCount(); i++ {
+		fn(*n.NamedChild(i))
+	}
+}
+
+// ImportDecl is a single `import` statement.
+type ImportDecl struct {
+	node
+}
+
+// Path returns the imported module path, as written in source (including
+// quotes).
+func (d *ImportDecl) Path() string {
+	if c := d.n.ChildByFieldName("path"); c != nil {
+		return string(d.src[c.StartByte():c.EndByte()])
+	}
+	return ""
+}
+
+// Alias returns the local alias the import is bound to, or "" if the import
+// has none.
+func (d *ImportDecl) Alias() string {
+	if c := d.n.ChildByFieldName("alias"); c != nil {
+		return string(d.src[c.StartByte():c.EndByte()])
+	}
+	return ""
+}
+
+// FunctionDecl is a top-level function declaration.
+type FunctionDecl struct {
+	node
+}
+
+// Name returns the function's identifier.
+func (d *FunctionDecl) Name() string {
+	if c := d.n.ChildByFieldName("name"); c != nil {
+		return string(d.src[c.StartByte():c.EndByte()])
+	}
+	return ""
+}
+
+// Params returns the function's parameter list, in declaration order.
+func (d *FunctionDecl) Params() []*Param {
+	list := d.n.ChildByFieldName("parameters")
+	if list == nil {
+		return nil
+	}
+	var out []*Param
+	walkNamedChildren(*list, func(c sitter.Node) {
+		if c.Type() == kindParameter {
+			out = append(out, &Param{node{n: c, src: d.src}})
+		}
+	})
+	return out
+}
+
+// Body returns the function's body block, or nil for a declaration without
+// one (e.g. an external/extern function).
+func (d *FunctionDecl) Body() *Block {
+	c := d.n.ChildByFieldName("body")
+	if c == nil {
+		return nil
+	}
+	return &Block{node{n: *c, src: d.src}}
+}
+
+// Param is a single entry in a function's parameter list.
+type Param struct {
+	node
+}
+
+// Name returns the parameter's identifier.
+func (p *Param) Name() string {
+	if c := p.n.ChildByFieldName("name"); c != nil {
+		return string(p.src[c.StartByte():c.EndByte()])
+	}
+	return ""
+}
+
+// Type returns the parameter's declared type, or "" if it has none.
+func (p *Param) Type() string {
+	if c := p.n.ChildByFieldName("type"); c != nil {
+		return string(p.src[c.StartByte():c.EndByte()])
+	}
+	return ""
+}
+
+// TypeDecl is a top-level `type` declaration.
+type TypeDecl struct {
+	node
+}
+
+// Name returns the declared type's identifier.
+func (d *TypeDecl) Name() string {
+	if c := d.n.ChildByFieldName("name"); c != nil {
+		return string(d.src[c.StartByte():c.EndByte()])
+	}
+	return ""
+}
+
+// Value returns the source text of the type's definition (its right-hand
+// side).
+func (d *TypeDecl) Value() string {
+	if c := d.n.ChildByFieldName("value"); c != nil {
+		return string(d.src[c.StartByte():c.EndByte()])
+	}
+	return ""
+}
+
+// Block is a `{ ... }` statement block, e.g. a function body.
+type Block struct {
+	node
+}
+
+// Statements returns the block's top-level statements, in source order.
+func (b *Block) Statements() []*Expr {
+	var out []*Expr
+	walkNamedChildren(b.n, func(c sitter.Node) {
+		out = append(out, &Expr{node{n: c, src: b.src}})
+	})
+	return out
+}
+
+// Expr is a generic wrapper around an expression or statement node that
+// doesn't have a more specific typed wrapper of its own (e.g. a binary
+// expression or a call). Use Kind to dispatch on the underlying grammar
+// node type and Text for its verbatim source.
+type Expr struct {
+	node
+}
+
+// Kind returns the node's grammar type, e.g. "return_statement", as listed
+// in src/node-types.json.
+func (e *Expr) Kind() string {
+	return e.n.Type()
+}
+
+// Expressions returns the file's top-level statements that aren't an
+// import, function, or type declaration, in source order.
+func (f *File) Expressions() []*Expr {
+	var out []*Expr
+	walkNamedChildren(f.n, func(c sitter.Node) {
+		switch c.Type() {
+		case kindImportStatement, kindFunctionDef, kindTypeDef:
+			return
+		}
+		out = append(out, &Expr{node{n: c, src: f.src}})
+	})
+	return out
+}
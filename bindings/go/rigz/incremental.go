@@ -0,0 +1,249 @@
+package rigz
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Position is a zero-based line/column pair, matching LSP's Position:
+// Column counts UTF-16 code units from the start of the line, not bytes.
+type Position struct {
+	Line, Column uint
+}
+
+// EditRange is a half-open [Start, End) span in LSP Position terms, matching
+// the shape of TextDocumentContentChangeEvent.Range.
+type EditRange struct {
+	Start, End Position
+}
+
+// Edit is one LSP TextDocumentContentChangeEvent: replace the text in Range
+// with Text.
+type Edit struct {
+	Range EditRange
+	Text  string
+}
+
+// IncrementalParser re-parses a single open document as edits come in,
+// reusing the previous *sitter.Tree so tree-sitter only re-parses the
+// changed region instead of the whole file.
+type IncrementalParser struct {
+	parser *Parser
+	src    []byte
+	tree   *sitter.Tree
+	prev   *sitter.Tree
+}
+
+// NewIncrementalParser returns an IncrementalParser seeded with the initial
+// contents of a document.
+func NewIncrementalParser(ctx context.Context, src []byte) (*IncrementalParser, error) {
+	p, err := NewParser()
+	if err != nil {
+		return nil, err
+	}
+	_, tree, err := p.Parse(ctx, src)
+	if err != nil {
+		p.Close()
+		return nil, err
+	}
+	return &IncrementalParser{parser: p, src: src, tree: tree}, nil
+}
+
+// Close releases the underlying parser and trees.
+func (ip *IncrementalParser) Close() {
+	if ip.tree != nil {
+		ip.tree.Close()
+	}
+	if ip.prev != nil {
+		ip.prev.Close()
+	}
+	ip.parser.Close()
+}
+
+// Tree returns the most recently parsed tree.
+func (ip *IncrementalParser) Tree() *sitter.Tree {
+	return ip.tree
+}
+
+// Source returns the document's current contents.
+func (ip *IncrementalParser) Source() []byte {
+	return ip.src
+}
+
+// ApplyEdits applies edits in order, updating the document and re-parsing
+// incrementally from the previous tree. Edits must be expressed against the
+// document as it exists at the time they're applied, as LSP requires.
+// The tree that was current before the call, with the edits applied to it,
+// stays valid until the next ApplyEdits or Close, so it can be passed to
+// ChangedRanges as old.
+func (ip *IncrementalParser) ApplyEdits(ctx context.Context, edits []Edit) (*File, error) {
+	for _, e := range edits {
+		if err := ip.applyEdit(e); err != nil {
+			return nil, err
+		}
+	}
+
+	tree := ip.parser.raw.ParseCtx(ctx, ip.src, ip.tree)
+	if tree == nil {
+		return nil, ctx.Err()
+	}
+	if ip.prev != nil {
+		ip.prev.Close()
+	}
+	ip.prev = ip.tree
+	ip.tree = tree
+	return newFile(*tree.RootNode(), ip.src), nil
+}
+
+func (ip *IncrementalParser) applyEdit(e Edit) error {
+	startByte, startPoint, err := positionToByte(ip.src, e.Range.Start)
+	if err != nil {
+		return err
+	}
+	oldEndByte, oldEndPoint, err := positionToByte(ip.src, e.Range.End)
+	if err != nil {
+		return err
+	}
+
+	newSrc := make([]byte, 0, len(ip.src)-int(oldEndByte-startByte)+len(e.Text))
+	newSrc = append(newSrc, ip.src[:startByte]...)
+	newSrc = append(newSrc, e.Text...)
+	newSrc = append(newSrc, ip.src[oldEndByte:]...)
+
+	newEndByte := startByte + uint(len(e.Text))
+	newEndPoint := bytePosition(newSrc, newEndByte)
+
+	ip.tree.Edit(&sitter.InputEdit{
+		StartByte:      startByte,
+		OldEndByte:     oldEndByte,
+		NewEndByte:     newEndByte,
+		StartPosition:  startPoint,
+		OldEndPosition: oldEndPoint,
+		NewEndPosition: newEndPoint,
+	})
+	ip.src = newSrc
+	return nil
+}
+
+// positionToByte converts an LSP Position (UTF-16 column) into a
+// tree-sitter byte offset and Point (byte column) within src.
+func positionToByte(src []byte, pos Position) (uint, sitter.Point, error) {
+	line := uint(0)
+	lineStart := 0
+	for line < pos.Line {
+		idx := bytes.IndexByte(src[lineStart:], '\n')
+		if idx < 0 {
+			return 0, sitter.Point{}, fmt.Errorf("rigz: line %d out of range", pos.Line)
+		}
+		lineStart += idx + 1
+		line++
+	}
+	lineEnd := len(src)
+	if idx := bytes.IndexByte(src[lineStart:], '\n'); idx >= 0 {
+		lineEnd = lineStart + idx
+	}
+	byteCol, err := utf16ColumnToByte(src[lineStart:lineEnd], pos.Column)
+	if err != nil {
+		return 0, sitter.Point{}, fmt.Errorf("rigz: column %d on line %d: %w", pos.Column, pos.Line, err)
+	}
+	byteOffset := lineStart + byteCol
+	return uint(byteOffset), sitter.Point{Row: pos.Line, Column: uint(byteCol)}, nil
+}
+
+// utf16ColumnToByte converts an LSP column (a count of UTF-16 code units
+// from the start of line) into a byte offset from the start of line.
+func utf16ColumnToByte(line []byte, utf16Col uint) (int, error) {
+	var units uint
+	byteOffset := 0
+	for byteOffset < len(line) {
+		if units == utf16Col {
+			return byteOffset, nil
+		}
+		r, size := utf8.DecodeRune(line[byteOffset:])
+		width := utf16.RuneLen(r)
+		if width < 0 {
+			return 0, fmt.Errorf("invalid rune at byte %d", byteOffset)
+		}
+		if units+uint(width) > utf16Col {
+			return 0, fmt.Errorf("column falls inside a multi-byte rune at byte %d", byteOffset)
+		}
+		units += uint(width)
+		byteOffset += size
+	}
+	if units == utf16Col {
+		return byteOffset, nil
+	}
+	return 0, fmt.Errorf("column exceeds line length")
+}
+
+// bytePosition computes the Point (row/column) of byteOffset within src.
+func bytePosition(src []byte, byteOffset uint) sitter.Point {
+	row, col := uint(0), uint(0)
+	for i := uint(0); i < byteOffset && int(i) < len(src); i++ {
+		if src[i] == '\n' {
+			row++
+			col = 0
+		} else {
+			col++
+		}
+	}
+	return sitter.Point{Row: row, Column: col}
+}
+
+// ChangedRanges reports the source ranges that differ between two
+// successive parses of the same document, e.g. to know which lines a
+// client needs to re-request diagnostics or semantic tokens for. src must
+// be the document contents that new was parsed from, since tree-sitter's
+// Points are byte columns and converting to LSP's UTF-16 columns requires
+// re-scanning the affected lines.
+func ChangedRanges(old, new *sitter.Tree, src []byte) []EditRange {
+	raw := old.ChangedRanges(new)
+	out := make([]EditRange, len(raw))
+	for i, r := range raw {
+		out[i] = EditRange{
+			Start: Position{Line: r.StartPoint.Row, Column: byteColumnToUTF16(lineAt(src, r.StartPoint.Row), r.StartPoint.Column)},
+			End:   Position{Line: r.EndPoint.Row, Column: byteColumnToUTF16(lineAt(src, r.EndPoint.Row), r.EndPoint.Column)},
+		}
+	}
+	return out
+}
+
+// lineAt returns the bytes of the line'th line (0-based) of src, excluding
+// its trailing newline.
+func lineAt(src []byte, line uint) []byte {
+	start := 0
+	for row := uint(0); row < line; row++ {
+		idx := bytes.IndexByte(src[start:], '\n')
+		if idx < 0 {
+			return nil
+		}
+		start += idx + 1
+	}
+	rest := src[start:]
+	if idx := bytes.IndexByte(rest, '\n'); idx >= 0 {
+		return rest[:idx]
+	}
+	return rest
+}
+
+// byteColumnToUTF16 converts a byte offset within line into an LSP
+// UTF-16 code-unit column. byteCol is clamped to len(line) so a Point
+// pointing just past the line's content (e.g. at its newline) doesn't
+// panic.
+func byteColumnToUTF16(line []byte, byteCol uint) uint {
+	if int(byteCol) > len(line) {
+		byteCol = uint(len(line))
+	}
+	var units uint
+	for i := uint(0); i < byteCol; {
+		r, size := utf8.DecodeRune(line[i:])
+		units += uint(utf16.RuneLen(r))
+		i += uint(size)
+	}
+	return units
+}
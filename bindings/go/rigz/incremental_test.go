@@ -0,0 +1,216 @@
+package rigz_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/tree-sitter/tree-sitter-rigz/bindings/go/rigz"
+)
+
+func TestIncrementalParserApplyEdits(t *testing.T) {
+	ctx := context.Background()
+	src := []byte("fn add(a: int, b: int) int {\n\treturn a + b\n}\n")
+
+	ip, err := rigz.NewIncrementalParser(ctx, src)
+	if err != nil {
+		t.Fatalf("NewIncrementalParser: %v", err)
+	}
+	defer ip.Close()
+
+	// Rename "add" to "sum" on line 0.
+	file, err := ip.ApplyEdits(ctx, []rigz.Edit{{
+		Range: rigz.EditRange{
+			Start: rigz.Position{Line: 0, Column: 3},
+			End:   rigz.Position{Line: 0, Column: 6},
+		},
+		Text: "sum",
+	}})
+	if err != nil {
+		t.Fatalf("ApplyEdits: %v", err)
+	}
+
+	fns := file.Functions()
+	if len(fns) != 1 || fns[0].Name() != "sum" {
+		t.Fatalf("Functions() = %v, want one function named sum", fns)
+	}
+	if got := string(ip.Source()); got != "fn sum(a: int, b: int) int {\n\treturn a + b\n}\n" {
+		t.Errorf("Source() = %q", got)
+	}
+}
+
+func TestChangedRanges(t *testing.T) {
+	ctx := context.Background()
+	src := []byte("fn add(a: int, b: int) int {\n\treturn a + b\n}\n")
+
+	ip, err := rigz.NewIncrementalParser(ctx, src)
+	if err != nil {
+		t.Fatalf("NewIncrementalParser: %v", err)
+	}
+	defer ip.Close()
+
+	before := ip.Tree()
+	if _, err := ip.ApplyEdits(ctx, []rigz.Edit{{
+		Range: rigz.EditRange{
+			Start: rigz.Position{Line: 1, Column: 9},
+			End:   rigz.Position{Line: 1, Column: 10},
+		},
+		Text: "a",
+	}}); err != nil {
+		t.Fatalf("ApplyEdits: %v", err)
+	}
+
+	ranges := rigz.ChangedRanges(before, ip.Tree(), ip.Source())
+	if len(ranges) == 0 {
+		t.Errorf("ChangedRanges() returned none, want at least one around the edit")
+	}
+	for _, r := range ranges {
+		if r.Start.Line > 1 || r.End.Line > 1 {
+			t.Errorf("ChangedRanges() = %+v, want changes confined to the edited line", ranges)
+		}
+	}
+}
+
+// TestChangedRangesUTF16Column checks that a changed range on a line
+// containing a multi-byte rune is reported in UTF-16 columns, like the rest
+// of this package's LSP-facing API, rather than tree-sitter's native byte
+// columns.
+func TestChangedRangesUTF16Column(t *testing.T) {
+	ctx := context.Background()
+	const lineContent = "\tlet s = \"héllo\"" // "é" is 2 bytes but 1 UTF-16 unit
+	src := []byte("fn f() {\n" + lineContent + "\n}\n")
+
+	utf16Len := uint(len(utf16.Encode([]rune(lineContent))))
+	byteLen := uint(len(lineContent))
+	if utf16Len == byteLen {
+		t.Fatalf("test line must contain a multi-byte rune")
+	}
+
+	ip, err := rigz.NewIncrementalParser(ctx, src)
+	if err != nil {
+		t.Fatalf("NewIncrementalParser: %v", err)
+	}
+	defer ip.Close()
+
+	before := ip.Tree()
+	if _, err := ip.ApplyEdits(ctx, []rigz.Edit{{
+		Range: rigz.EditRange{
+			Start: rigz.Position{Line: 1, Column: utf16Len},
+			End:   rigz.Position{Line: 1, Column: utf16Len},
+		},
+		Text: "!",
+	}}); err != nil {
+		t.Fatalf("ApplyEdits: %v", err)
+	}
+
+	ranges := rigz.ChangedRanges(before, ip.Tree(), ip.Source())
+	found := false
+	for _, r := range ranges {
+		if r.Start.Line != 1 {
+			continue
+		}
+		found = true
+		if r.Start.Column > utf16Len {
+			t.Errorf("Start.Column = %d, want <= %d (the line's UTF-16 length; byte length is %d) — looks like a raw byte offset leaked through",
+				r.Start.Column, utf16Len, byteLen)
+		}
+	}
+	if !found {
+		t.Fatalf("ChangedRanges() reported nothing on line 1")
+	}
+}
+
+// TestReparseCostProportionalToEditSize asserts, deterministically rather
+// than by eyeballing a benchmark, that a single small edit to a large
+// document only invalidates a small, bounded slice of it: ChangedRanges
+// should cover a handful of bytes around the edit, not the whole file. A
+// regression to full-reparse-every-edit would make this fail instead of
+// just making BenchmarkIncrementalParserSingleLineEdit slower.
+func TestReparseCostProportionalToEditSize(t *testing.T) {
+	ctx := context.Background()
+	src := largeSource(2000)
+
+	ip, err := rigz.NewIncrementalParser(ctx, src)
+	if err != nil {
+		t.Fatalf("NewIncrementalParser: %v", err)
+	}
+	defer ip.Close()
+
+	before := ip.Tree()
+	if _, err := ip.ApplyEdits(ctx, []rigz.Edit{{
+		Range: rigz.EditRange{
+			Start: rigz.Position{Line: 1, Column: 9},
+			End:   rigz.Position{Line: 1, Column: 9},
+		},
+		Text: "a",
+	}}); err != nil {
+		t.Fatalf("ApplyEdits: %v", err)
+	}
+
+	ranges := rigz.ChangedRanges(before, ip.Tree(), ip.Source())
+	const maxChangedLines = 10
+	for _, r := range ranges {
+		if r.End.Line-r.Start.Line > maxChangedLines {
+			t.Errorf("ChangedRanges() = %+v spans %d lines, want within %d of a single-character edit on a %d-line document",
+				ranges, r.End.Line-r.Start.Line, maxChangedLines, 2000*4)
+		}
+	}
+}
+
+// largeSource repeats a function declaration enough times to make a
+// from-scratch parse dominate an incremental benchmark if edit tracking
+// isn't actually reusing the old tree.
+func largeSource(n int) []byte {
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString("fn f(a: int, b: int) int {\n\treturn a + b\n}\n\n")
+	}
+	return []byte(b.String())
+}
+
+func BenchmarkIncrementalParserSingleLineEdit(b *testing.B) {
+	ctx := context.Background()
+	src := largeSource(2000)
+
+	ip, err := rigz.NewIncrementalParser(ctx, src)
+	if err != nil {
+		b.Fatalf("NewIncrementalParser: %v", err)
+	}
+	defer ip.Close()
+
+	edit := rigz.Edit{
+		Range: rigz.EditRange{
+			Start: rigz.Position{Line: 1, Column: 9},
+			End:   rigz.Position{Line: 1, Column: 9},
+		},
+		Text: "a",
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ip.ApplyEdits(ctx, []rigz.Edit{edit}); err != nil {
+			b.Fatalf("ApplyEdits: %v", err)
+		}
+	}
+}
+
+func BenchmarkFullParseForComparison(b *testing.B) {
+	ctx := context.Background()
+	src := largeSource(2000)
+
+	p, err := rigz.NewParser()
+	if err != nil {
+		b.Fatalf("NewParser: %v", err)
+	}
+	defer p.Close()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, tree, err := p.Parse(ctx, src)
+		if err != nil {
+			b.Fatalf("Parse: %v", err)
+		}
+		tree.Close()
+	}
+}
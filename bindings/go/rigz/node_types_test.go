@@ -0,0 +1,46 @@
+package rigz
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+// TestNodeKindsMatchGrammar keeps the kind* constants honest against
+// src/node-types.json: it's the "stay in sync with the grammar" mechanism
+// the hand-written wrappers in ast.go rely on, since there's no code
+// generator producing them. If the grammar renames or removes a node type
+// this package dispatches on, this test fails instead of the drift going
+// unnoticed until something silently stops matching at runtime.
+func TestNodeKindsMatchGrammar(t *testing.T) {
+	data, err := os.ReadFile("../../../src/node-types.json")
+	if err != nil {
+		t.Fatalf("reading node-types.json: %v", err)
+	}
+	var entries []struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		t.Fatalf("parsing node-types.json: %v", err)
+	}
+
+	declared := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		declared[e.Type] = true
+	}
+
+	for _, kind := range []string{
+		kindSourceFile,
+		kindImportStatement,
+		kindFunctionDef,
+		kindParameterList,
+		kindParameter,
+		kindTypeDef,
+		kindBlock,
+		kindIdentifier,
+	} {
+		if !declared[kind] {
+			t.Errorf("kind %q is not declared in node-types.json; update the kind* constants in rigz.go", kind)
+		}
+	}
+}
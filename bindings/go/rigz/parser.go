@@ -0,0 +1,41 @@
+package rigz
+
+import (
+	"context"
+
+	sitter "github.com/tree-sitter/go-tree-sitter"
+
+	tree_sitter_rigz "github.com/tree-sitter/tree-sitter-rigz/bindings/go"
+)
+
+// Parser parses Rigz source into a typed *File AST. It wraps a
+// *sitter.Parser already configured with the Rigz grammar.
+type Parser struct {
+	raw *sitter.Parser
+}
+
+// NewParser returns a Parser configured with the Rigz grammar.
+func NewParser() (*Parser, error) {
+	raw := sitter.NewParser()
+	if err := raw.SetLanguage(sitter.NewLanguage(tree_sitter_rigz.Language())); err != nil {
+		return nil, err
+	}
+	return &Parser{raw: raw}, nil
+}
+
+// Parse parses src and returns the root *File along with the underlying
+// *sitter.Tree. The caller owns the returned tree and must call tree.Close()
+// once it's no longer needed; File accessors stay valid as long as the tree
+// (and src) are kept alive.
+func (p *Parser) Parse(ctx context.Context, src []byte) (*File, *sitter.Tree, error) {
+	tree := p.raw.ParseCtx(ctx, src, nil)
+	if tree == nil {
+		return nil, nil, ctx.Err()
+	}
+	return newFile(*tree.RootNode(), src), tree, nil
+}
+
+// Close releases the resources held by the underlying tree-sitter parser.
+func (p *Parser) Close() {
+	p.raw.Close()
+}
@@ -0,0 +1,28 @@
+// Package queries embeds the Rigz tree-sitter query files (highlights,
+// locals, injections, tags) so they ship with the Go module instead of
+// requiring consumers to vendor the queries/ directory themselves.
+//
+// go:embed can't reach outside its package directory, so the .scm files
+// here are copies of the ones in the top-level queries/ directory. Run
+// `go generate` after editing the originals to keep them in sync.
+package queries
+
+//go:generate cp ../../../../queries/highlights.scm ../../../../queries/locals.scm ../../../../queries/injections.scm ../../../../queries/tags.scm .
+
+import (
+	"embed"
+	"fmt"
+)
+
+//go:embed highlights.scm locals.scm injections.scm tags.scm
+var files embed.FS
+
+// Load returns the contents of the named query file, e.g. Load("highlights")
+// for queries/highlights.scm.
+func Load(name string) ([]byte, error) {
+	data, err := files.ReadFile(name + ".scm")
+	if err != nil {
+		return nil, fmt.Errorf("rigz queries: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,46 @@
+// Package rigz is a typed, high-level API on top of the raw tree-sitter
+// grammar binding in github.com/tree-sitter/tree-sitter-rigz/bindings/go.
+//
+// The raw binding only exposes Language(), an unsafe.Pointer suitable for
+// github.com/tree-sitter/go-tree-sitter's Parser.SetLanguage. Everything in
+// this package builds on top of that to give callers a *File AST with typed
+// accessors instead of making them dispatch on Node.Type() strings
+// themselves. The node wrappers mirror ../../../src/node-types.json and should
+// be kept in sync with it as the grammar evolves.
+package rigz
+
+import (
+	sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Node kinds, mirrored from src/node-types.json. There's no code generator
+// for these yet, so TestNodeKindsMatchGrammar checks them against
+// node-types.json directly; update both when the grammar changes.
+const (
+	kindSourceFile      = "source_file"
+	kindImportStatement = "import_statement"
+	kindFunctionDef     = "function_definition"
+	kindParameterList   = "parameter_list"
+	kindParameter       = "parameter"
+	kindTypeDef         = "type_definition"
+	kindBlock           = "block"
+	kindIdentifier      = "identifier"
+)
+
+// node is embedded by every typed wrapper in this package. It carries the
+// raw tree-sitter node plus the source bytes it was parsed from, which is
+// what Text and the field accessors need.
+type node struct {
+	n   sitter.Node
+	src []byte
+}
+
+// Text returns the verbatim source text spanned by the node.
+func (n node) Text() string {
+	return string(n.src[n.n.StartByte():n.n.EndByte()])
+}
+
+// Range returns the byte range spanned by the node.
+func (n node) Range() (start, end uint) {
+	return n.n.StartByte(), n.n.EndByte()
+}
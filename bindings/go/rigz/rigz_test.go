@@ -0,0 +1,55 @@
+package rigz_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tree-sitter/tree-sitter-rigz/bindings/go/rigz"
+)
+
+func TestParseTopLevelDecls(t *testing.T) {
+	p, err := rigz.NewParser()
+	if err != nil {
+		t.Fatalf("NewParser: %v", err)
+	}
+	defer p.Close()
+
+	src := []byte(`import "std/io"
+
+fn add(a: int, b: int) int {
+	return a + b
+}
+
+type Point = { x: int, y: int }
+`)
+
+	file, tree, err := p.Parse(context.Background(), src)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	defer tree.Close()
+
+	if got := file.Imports(); len(got) != 1 || got[0].Path() != `"std/io"` {
+		t.Errorf("Imports() = %v, want one import of \"std/io\"", got)
+	}
+
+	fns := file.Functions()
+	if len(fns) != 1 || fns[0].Name() != "add" {
+		t.Fatalf("Functions() = %v, want one function named add", fns)
+	}
+	if params := fns[0].Params(); len(params) != 2 || params[0].Name() != "a" || params[1].Name() != "b" {
+		t.Errorf("Params() = %v, want a, b", params)
+	}
+	body := fns[0].Body()
+	if body == nil {
+		t.Fatalf("Body() = nil, want a block")
+	}
+	if stmts := body.Statements(); len(stmts) != 1 || stmts[0].Kind() != "return_statement" {
+		t.Errorf("Statements() = %v, want one return_statement", stmts)
+	}
+
+	types := file.Types()
+	if len(types) != 1 || types[0].Name() != "Point" {
+		t.Fatalf("Types() = %v, want one type named Point", types)
+	}
+}
@@ -0,0 +1,143 @@
+package rigz
+
+import (
+	"fmt"
+	"sync"
+
+	sitter "github.com/tree-sitter/go-tree-sitter"
+
+	tree_sitter_rigz "github.com/tree-sitter/tree-sitter-rigz/bindings/go"
+	"github.com/tree-sitter/tree-sitter-rigz/bindings/go/rigz/queries"
+)
+
+// SymbolKind is the kind of a Symbol. Symbols derives it from the grammar
+// type of the captured @definition.* / @reference.* node, not from the
+// capture name; the values match the part after the dot of the capture
+// names in queries/tags.scm.
+type SymbolKind string
+
+const (
+	SymbolFunction SymbolKind = "function"
+	SymbolType     SymbolKind = "type"
+	SymbolImport   SymbolKind = "import"
+)
+
+// Symbol is one entry produced by running queries/tags.scm over a tree, the
+// shape LSP documentSymbol/workspaceSymbol implementations need.
+type Symbol struct {
+	Kind  SymbolKind
+	Name  string
+	Range Range
+	Scope *sitter.Node // enclosing node the symbol is defined in, if any
+}
+
+// Range is a byte-and-point span, mirroring sitter.Node's StartByte/EndByte
+// and StartPosition/EndPosition pair.
+type Range struct {
+	StartByte, EndByte   uint
+	StartPoint, EndPoint sitter.Point
+}
+
+func rangeOf(n sitter.Node) Range {
+	return Range{
+		StartByte:  n.StartByte(),
+		EndByte:    n.EndByte(),
+		StartPoint: n.StartPosition(),
+		EndPoint:   n.EndPosition(),
+	}
+}
+
+var (
+	tagsQueryOnce sync.Once
+	tagsQuery     *sitter.Query
+	tagsQueryErr  error
+)
+
+// loadTagsQuery compiles queries/tags.scm once and caches it; Symbols is
+// meant for concurrent use across documents (e.g. an LSP server handling
+// several open files), so the compile must only happen once.
+func loadTagsQuery() (*sitter.Query, error) {
+	tagsQueryOnce.Do(func() {
+		src, err := queries.Load("tags")
+		if err != nil {
+			tagsQueryErr = err
+			return
+		}
+		q, err := sitter.NewQuery(sitter.NewLanguage(tree_sitter_rigz.Language()), string(src))
+		if err != nil {
+			tagsQueryErr = fmt.Errorf("rigz: compiling tags query: %w", err)
+			return
+		}
+		tagsQuery = q
+	})
+	return tagsQuery, tagsQueryErr
+}
+
+// Symbols runs queries/tags.scm over tree and returns one Symbol per
+// @definition.*/@reference.* match, in the order tree-sitter reports them.
+func Symbols(tree *sitter.Tree, src []byte) ([]Symbol, error) {
+	q, err := loadTagsQuery()
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := sitter.NewQueryCursor()
+	defer cursor.Close()
+
+	var out []Symbol
+	matches := cursor.Matches(q, tree.RootNode(), src)
+	for {
+		match := matches.Next()
+		if match == nil {
+			break
+		}
+		var name string
+		var defNode *sitter.Node
+		for _, capture := range match.Captures {
+			captureName := q.CaptureNames()[capture.Index]
+			switch {
+			case captureName == "name":
+				name = string(src[capture.Node.StartByte():capture.Node.EndByte()])
+			case captureName == "definition.function" || captureName == "definition.type" || captureName == "reference.import":
+				n := capture.Node
+				defNode = &n
+			}
+		}
+		if defNode == nil || name == "" {
+			continue
+		}
+		var kind SymbolKind
+		switch defNode.Type() {
+		case kindFunctionDef:
+			kind = SymbolFunction
+		case kindTypeDef:
+			kind = SymbolType
+		case kindImportStatement:
+			kind = SymbolImport
+		default:
+			continue
+		}
+		out = append(out, Symbol{
+			Kind:  kind,
+			Name:  name,
+			Range: rangeOf(*defNode),
+			Scope: enclosingScope(*defNode),
+		})
+	}
+	return out, nil
+}
+
+// enclosingScope walks up from n to the nearest source_file or block
+// ancestor, matching the scopes defined in queries/locals.scm.
+func enclosingScope(n sitter.Node) *sitter.Node {
+	parent := n.Parent()
+	for parent != nil {
+		switch parent.Type() {
+		case kindBlock, kindSourceFile:
+			p := *parent
+			return &p
+		}
+		parent = parent.Parent()
+	}
+	return nil
+}
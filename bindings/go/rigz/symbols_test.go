@@ -0,0 +1,50 @@
+package rigz_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tree-sitter/tree-sitter-rigz/bindings/go/rigz"
+)
+
+func TestSymbols(t *testing.T) {
+	p, err := rigz.NewParser()
+	if err != nil {
+		t.Fatalf("NewParser: %v", err)
+	}
+	defer p.Close()
+
+	src := []byte(`import "std/io"
+
+fn add(a: int, b: int) int {
+	return a + b
+}
+
+type Point = { x: int, y: int }
+`)
+
+	_, tree, err := p.Parse(context.Background(), src)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	defer tree.Close()
+
+	symbols, err := rigz.Symbols(tree, src)
+	if err != nil {
+		t.Fatalf("Symbols: %v", err)
+	}
+
+	want := map[string]rigz.SymbolKind{
+		"add":      rigz.SymbolFunction,
+		"Point":    rigz.SymbolType,
+		`"std/io"`: rigz.SymbolImport,
+	}
+	if len(symbols) != len(want) {
+		t.Fatalf("Symbols() returned %d symbols, want %d: %+v", len(symbols), len(want), symbols)
+	}
+	for _, s := range symbols {
+		if kind, ok := want[s.Name]; !ok || kind != s.Kind {
+			t.Errorf("unexpected symbol %+v", s)
+		}
+	}
+}
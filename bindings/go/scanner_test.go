@@ -0,0 +1,95 @@
+package tree_sitter_rigz_test
+
+import (
+	"testing"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_rigz "github.com/tree-sitter/tree-sitter-rigz/bindings/go"
+)
+
+func newTestParser(t *testing.T) *tree_sitter.Parser {
+	t.Helper()
+	parser := tree_sitter.NewParser()
+	if err := parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_rigz.Language())); err != nil {
+		t.Fatalf("SetLanguage: %v", err)
+	}
+	return parser
+}
+
+// assertNoError walks the tree looking for ERROR/MISSING nodes, which is how
+// tree-sitter reports that the external scanner (or the grammar around it)
+// rejected the input.
+func assertNoError(t *testing.T, tree *tree_sitter.Tree) {
+	t.Helper()
+	var walk func(n tree_sitter.Node)
+	walk = func(n tree_sitter.Node) {
+		if n.IsError() || n.IsMissing() {
+			t.Errorf("parse error at byte %d: %s", n.StartByte(), n.Type())
+		}
+		for i := uint(0); i < n.ChildCount(); i++ {
+			walk(*n.Child(i))
+		}
+	}
+	walk(*tree.RootNode())
+}
+
+func TestScannerNestedInterpolation(t *testing.T) {
+	parser := newTestParser(t)
+	defer parser.Close()
+
+	src := []byte(`fn greet(name: string) string {
+	return "hello {"{"nested"}"}{name}!"
+}
+`)
+	tree := parser.Parse(src, nil)
+	defer tree.Close()
+	assertNoError(t, tree)
+}
+
+func TestScannerMultilineRawString(t *testing.T) {
+	parser := newTestParser(t)
+	defer parser.Close()
+
+	src := []byte("let doc = ##\"line one\nline two with a bare \" quote\nline three\"##\n")
+	tree := parser.Parse(src, nil)
+	defer tree.Close()
+	assertNoError(t, tree)
+}
+
+func TestScannerAmbiguousUnaryMinus(t *testing.T) {
+	parser := newTestParser(t)
+	defer parser.Close()
+
+	src := []byte("let a = 1\nlet b = -a - -1\n")
+	tree := parser.Parse(src, nil)
+	defer tree.Close()
+	assertNoError(t, tree)
+}
+
+// TestScannerSerializeRoundTrip exercises the scanner's serialize/deserialize
+// path by re-parsing after an incremental edit: tree-sitter only persists
+// external scanner state across an edit, so this would catch a scanner that
+// forgets interpolation depth between calls.
+func TestScannerSerializeRoundTrip(t *testing.T) {
+	parser := newTestParser(t)
+	defer parser.Close()
+
+	src := []byte(`let s = "outer {"inner"} tail"` + "\n")
+	tree := parser.Parse(src, nil)
+	defer tree.Close()
+	assertNoError(t, tree)
+
+	edited := []byte(`let s = "outer {"inner, edited"} tail"` + "\n")
+	tree.Edit(&tree_sitter.InputEdit{
+		StartByte:      22,
+		OldEndByte:     22,
+		NewEndByte:     30,
+		StartPosition:  tree_sitter.Point{Row: 0, Column: 22},
+		OldEndPosition: tree_sitter.Point{Row: 0, Column: 22},
+		NewEndPosition: tree_sitter.Point{Row: 0, Column: 30},
+	})
+
+	reparsed := parser.Parse(edited, tree)
+	defer reparsed.Close()
+	assertNoError(t, reparsed)
+}